@@ -0,0 +1,211 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import "math/big"
+
+// lessBytes reports whether a sorts before b as unsigned byte strings,
+// shorter-is-smaller when one is a prefix of the other.
+func lessBytes(a, b []byte) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// bigIntKey encodes v as a sign byte (0x00 negative, 0x01 zero, 0x02
+// positive) followed by v's magnitude, left-padded to width bytes and
+// bitwise-inverted if v is negative, so more-negative values sort
+// earlier.
+func bigIntKey(v *big.Int, width int) []byte {
+	key := make([]byte, 1+width)
+	switch {
+	case v == nil || v.Sign() == 0:
+		key[0] = 1
+	case v.Sign() > 0:
+		key[0] = 2
+		v.FillBytes(key[1:])
+	default:
+		key[0] = 0
+		new(big.Int).Abs(v).FillBytes(key[1:])
+		for i := 1; i < len(key); i++ {
+			key[i] = ^key[i]
+		}
+	}
+	return key
+}
+
+// bigIntKeyedSlice pairs a []*big.Int with its precomputed byte keys, so
+// BigInts only computes the max width and one key per element once
+// rather than on every Key call during the sort.
+type bigIntKeyedSlice struct {
+	vals []*big.Int
+	keys [][]byte
+}
+
+func (p *bigIntKeyedSlice) Len() int           { return len(p.vals) }
+func (p *bigIntKeyedSlice) Less(i, j int) bool { return lessBytes(p.keys[i], p.keys[j]) }
+func (p *bigIntKeyedSlice) Swap(i, j int) {
+	p.vals[i], p.vals[j] = p.vals[j], p.vals[i]
+	p.keys[i], p.keys[j] = p.keys[j], p.keys[i]
+}
+func (p *bigIntKeyedSlice) Key(i int) []byte { return p.keys[i] }
+
+// BigIntSlice attaches the methods of BytesInterface to []*big.Int,
+// sorting in increasing order. See bigIntKey for the encoding used.
+//
+// Key recomputes the slice-wide width on every call, which is fine for
+// ByBytesFunc-style one-off use but quadratic if called in a sort loop;
+// Sort and BigInts instead compute the width once and sort from
+// precomputed keys.
+type BigIntSlice []*big.Int
+
+func (p BigIntSlice) Len() int      { return len(p) }
+func (p BigIntSlice) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p BigIntSlice) Less(i, j int) bool {
+	width := p.maxWidth()
+	return lessBytes(bigIntKey(p[i], width), bigIntKey(p[j], width))
+}
+func (p BigIntSlice) Key(i int) []byte { return bigIntKey(p[i], p.maxWidth()) }
+
+func (p BigIntSlice) maxWidth() int {
+	width := 0
+	for _, v := range p {
+		if v == nil {
+			continue
+		}
+		if n := len(v.Bytes()); n > width {
+			width = n
+		}
+	}
+	return width
+}
+
+// Sort is a convenience method.
+func (p BigIntSlice) Sort() { BigInts(p) }
+
+// BigInts sorts a slice of *big.Int in increasing order.
+func BigInts(a []*big.Int) {
+	width := BigIntSlice(a).maxWidth()
+	keys := make([][]byte, len(a))
+	for i, v := range a {
+		keys[i] = bigIntKey(v, width)
+	}
+	ByBytes(&bigIntKeyedSlice{vals: a, keys: keys})
+}
+
+// BigIntsAreSorted tests whether a slice of *big.Int is sorted in
+// increasing order.
+func BigIntsAreSorted(a []*big.Int) bool { return IsSorted(BigIntSlice(a)) }
+
+// bigRatKeyedSlice is BigRatSlice's analog of bigIntKeyedSlice: the
+// cross-multiplied numerators (over a denominator shared by the whole
+// slice) are computed once, up front.
+type bigRatKeyedSlice struct {
+	vals []*big.Rat
+	keys [][]byte
+}
+
+func (p *bigRatKeyedSlice) Len() int           { return len(p.vals) }
+func (p *bigRatKeyedSlice) Less(i, j int) bool { return lessBytes(p.keys[i], p.keys[j]) }
+func (p *bigRatKeyedSlice) Swap(i, j int) {
+	p.vals[i], p.vals[j] = p.vals[j], p.vals[i]
+	p.keys[i], p.keys[j] = p.keys[j], p.keys[i]
+}
+func (p *bigRatKeyedSlice) Key(i int) []byte { return p.keys[i] }
+
+// BigRatSlice attaches the methods of BytesInterface to []*big.Rat,
+// sorting in increasing order. Each key cross-multiplies the rational's
+// numerator against a denominator shared across the whole slice (the
+// product of the slice's distinct denominators), then encodes the
+// resulting integer the same way bigIntKey does.
+//
+// As with BigIntSlice, Key recomputes the shared denominator and width
+// on every call; Sort and BigRats compute them once.
+type BigRatSlice []*big.Rat
+
+func (p BigRatSlice) Len() int      { return len(p) }
+func (p BigRatSlice) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p BigRatSlice) Less(i, j int) bool {
+	den := p.commonDenominator()
+	nums := p.scaledNumerators(den)
+	width := maxBytesWidth(nums)
+	return lessBytes(bigIntKey(nums[i], width), bigIntKey(nums[j], width))
+}
+func (p BigRatSlice) Key(i int) []byte {
+	den := p.commonDenominator()
+	nums := p.scaledNumerators(den)
+	return bigIntKey(nums[i], maxBytesWidth(nums))
+}
+
+// commonDenominator returns the product of the distinct denominators
+// appearing in p.
+func (p BigRatSlice) commonDenominator() *big.Int {
+	seen := make(map[string]bool, len(p))
+	den := big.NewInt(1)
+	for _, v := range p {
+		if v == nil {
+			continue
+		}
+		d := v.Denom()
+		key := d.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		den.Mul(den, d)
+	}
+	return den
+}
+
+// scaledNumerators returns, for each element, the integer n such that
+// n / den == the element's value (0 for a nil element).
+func (p BigRatSlice) scaledNumerators(den *big.Int) []*big.Int {
+	nums := make([]*big.Int, len(p))
+	for i, v := range p {
+		if v == nil {
+			nums[i] = big.NewInt(0)
+			continue
+		}
+		scale := new(big.Int).Div(den, v.Denom())
+		nums[i] = new(big.Int).Mul(v.Num(), scale)
+	}
+	return nums
+}
+
+func maxBytesWidth(nums []*big.Int) int {
+	width := 0
+	for _, n := range nums {
+		if b := len(n.Bytes()); b > width {
+			width = b
+		}
+	}
+	return width
+}
+
+// Sort is a convenience method.
+func (p BigRatSlice) Sort() { BigRats(p) }
+
+// BigRats sorts a slice of *big.Rat in increasing order.
+func BigRats(a []*big.Rat) {
+	p := BigRatSlice(a)
+	den := p.commonDenominator()
+	nums := p.scaledNumerators(den)
+	width := maxBytesWidth(nums)
+	keys := make([][]byte, len(a))
+	for i, n := range nums {
+		keys[i] = bigIntKey(n, width)
+	}
+	ByBytes(&bigRatKeyedSlice{vals: a, keys: keys})
+}
+
+// BigRatsAreSorted tests whether a slice of *big.Rat is sorted in
+// increasing order.
+func BigRatsAreSorted(a []*big.Rat) bool { return IsSorted(BigRatSlice(a)) }
@@ -0,0 +1,66 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"math/big"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestBigInts(t *testing.T) {
+	r := rand.New(rand.NewSource(20))
+	a := make([]*big.Int, 500)
+	for i := range a {
+		a[i] = big.NewInt(int64(r.Intn(200000) - 100000))
+	}
+	want := append([]*big.Int(nil), a...)
+	sort.Slice(want, func(i, j int) bool { return want[i].Cmp(want[j]) < 0 })
+
+	BigInts(a)
+	for i := range a {
+		if a[i].Cmp(want[i]) != 0 {
+			t.Fatalf("mismatch at %d: got %v, want %v", i, a[i], want[i])
+		}
+	}
+	if !BigIntsAreSorted(a) {
+		t.Fatalf("BigIntsAreSorted returned false after BigInts")
+	}
+}
+
+func TestBigRats(t *testing.T) {
+	r := rand.New(rand.NewSource(21))
+	a := make([]*big.Rat, 300)
+	for i := range a {
+		num := int64(r.Intn(2000) - 1000)
+		den := int64(r.Intn(20) + 1)
+		a[i] = big.NewRat(num, den)
+	}
+	want := append([]*big.Rat(nil), a...)
+	sort.Slice(want, func(i, j int) bool { return want[i].Cmp(want[j]) < 0 })
+
+	BigRats(a)
+	for i := range a {
+		if a[i].Cmp(want[i]) != 0 {
+			t.Fatalf("mismatch at %d: got %v, want %v", i, a[i], want[i])
+		}
+	}
+	if !BigRatsAreSorted(a) {
+		t.Fatalf("BigRatsAreSorted returned false after BigRats")
+	}
+}
+
+func TestBigRatSliceCommonDenominatorDedups(t *testing.T) {
+	p := BigRatSlice{big.NewRat(1, 6), big.NewRat(1, 6), big.NewRat(1, 3)}
+	// The distinct denominators are 6 and 3, so the (deduped) common
+	// denominator is 18, not 6*6*3 = 108.
+	if got, want := p.commonDenominator(), big.NewInt(18); got.Cmp(want) != 0 {
+		t.Fatalf("commonDenominator() = %v, want %v", got, want)
+	}
+}
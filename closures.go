@@ -0,0 +1,90 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"bytes"
+	"reflect"
+)
+
+// numberFuncSlice adapts an arbitrary slice and a uint64 key function to
+// NumberInterface, so ByNumberFunc can hand it to ByNumber.
+type numberFuncSlice struct {
+	swap func(i, j int)
+	key  func(i int) uint64
+	n    int
+}
+
+func (p *numberFuncSlice) Len() int           { return p.n }
+func (p *numberFuncSlice) Less(i, j int) bool { return p.key(i) < p.key(j) }
+func (p *numberFuncSlice) Swap(i, j int)      { p.swap(i, j) }
+func (p *numberFuncSlice) Key(i int) uint64   { return p.key(i) }
+
+// ByNumberFunc sorts data, a slice, in increasing order as determined by
+// the key function. ByNumberFunc panics if data is not a slice.
+//
+// The key function is called repeatedly during the sort, so it should be
+// cheap. It must compute the key of the element currently at index i of
+// data: the sort swaps only data's elements, so keys precomputed into a
+// separate slice indexed by i do not follow the swaps.
+func ByNumberFunc(data interface{}, key func(i int) uint64) {
+	v := reflect.ValueOf(data)
+	ByNumber(&numberFuncSlice{
+		swap: reflect.Swapper(data),
+		key:  key,
+		n:    v.Len(),
+	})
+}
+
+// stringFuncSlice adapts an arbitrary slice and a string key function to
+// StringInterface, so ByStringFunc can hand it to ByString.
+type stringFuncSlice struct {
+	swap func(i, j int)
+	key  func(i int) string
+	n    int
+}
+
+func (p *stringFuncSlice) Len() int           { return p.n }
+func (p *stringFuncSlice) Less(i, j int) bool { return p.key(i) < p.key(j) }
+func (p *stringFuncSlice) Swap(i, j int)      { p.swap(i, j) }
+func (p *stringFuncSlice) Key(i int) string   { return p.key(i) }
+
+// ByStringFunc sorts data, a slice, in increasing order as determined by
+// the key function. ByStringFunc panics if data is not a slice.
+func ByStringFunc(data interface{}, key func(i int) string) {
+	v := reflect.ValueOf(data)
+	ByString(&stringFuncSlice{
+		swap: reflect.Swapper(data),
+		key:  key,
+		n:    v.Len(),
+	})
+}
+
+// bytesFuncSlice adapts an arbitrary slice and a []byte key function to
+// BytesInterface, so ByBytesFunc can hand it to ByBytes.
+type bytesFuncSlice struct {
+	swap func(i, j int)
+	key  func(i int) []byte
+	n    int
+}
+
+func (p *bytesFuncSlice) Len() int           { return p.n }
+func (p *bytesFuncSlice) Less(i, j int) bool { return bytes.Compare(p.key(i), p.key(j)) == -1 }
+func (p *bytesFuncSlice) Swap(i, j int)      { p.swap(i, j) }
+func (p *bytesFuncSlice) Key(i int) []byte   { return p.key(i) }
+
+// ByBytesFunc sorts data, a slice, in increasing order as determined by
+// the key function. ByBytesFunc panics if data is not a slice.
+func ByBytesFunc(data interface{}, key func(i int) []byte) {
+	v := reflect.ValueOf(data)
+	ByBytes(&bytesFuncSlice{
+		swap: reflect.Swapper(data),
+		key:  key,
+		n:    v.Len(),
+	})
+}
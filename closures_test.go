@@ -0,0 +1,64 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestByNumberFunc(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{
+		{"carol", 35},
+		{"alice", 30},
+		{"dave", 20},
+		{"bob", 30},
+	}
+	ByNumberFunc(people, func(i int) uint64 { return IntKey(people[i].age) })
+	want := []int{20, 30, 30, 35}
+	for i, p := range people {
+		if p.age != want[i] {
+			t.Fatalf("position %d: got age %d, want %d (%+v)", i, p.age, want[i], people)
+		}
+	}
+}
+
+func TestByStringFunc(t *testing.T) {
+	type person struct {
+		name string
+	}
+	people := []person{{"carol"}, {"alice"}, {"dave"}, {"bob"}}
+	ByStringFunc(people, func(i int) string { return people[i].name })
+	want := []string{"alice", "bob", "carol", "dave"}
+	for i, p := range people {
+		if p.name != want[i] {
+			t.Fatalf("position %d: got %q, want %q", i, p.name, want[i])
+		}
+	}
+}
+
+func TestByBytesFunc(t *testing.T) {
+	type record struct {
+		key []byte
+	}
+	records := []record{{[]byte("carol")}, {[]byte("alice")}, {[]byte("dave")}, {[]byte("bob")}}
+	ByBytesFunc(records, func(i int) []byte { return records[i].key })
+	want := []string{"alice", "bob", "carol", "dave"}
+	for i, r := range records {
+		if string(r.key) != want[i] {
+			t.Fatalf("position %d: got %q, want %q", i, r.key, want[i])
+		}
+	}
+	if !sort.SliceIsSorted(records, func(i, j int) bool { return string(records[i].key) < string(records[j].key) }) {
+		t.Fatalf("records not sorted: %+v", records)
+	}
+}
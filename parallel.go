@@ -0,0 +1,297 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"runtime"
+	"sync"
+)
+
+// MaxProcs is the number of goroutines the Parallel entry points
+// (ByNumberParallel, ByStringParallel, ByBytesParallel, and the
+// convenience wrappers built on them) are willing to use at once. It
+// defaults to runtime.GOMAXPROCS(0); use SetMaxProcs to change it.
+var MaxProcs = runtime.GOMAXPROCS(0)
+
+// SetMaxProcs sets the number of goroutines used by the Parallel sort
+// entry points. Values below 1 are treated as 1.
+func SetMaxProcs(n int) {
+	if n < 1 {
+		n = 1
+	}
+	MaxProcs = n
+}
+
+// smallBucket is the input length below which the Parallel entry points
+// skip partitioning and hand the whole input to the ordinary serial sort
+// (which in turn falls back to insertion sort for tiny inputs). Once the
+// input is partitioned, every most-significant-byte bucket is finished by
+// the serial sort, whatever its size.
+const smallBucket = 128
+
+// swapper is the subset of the sort interfaces permute needs.
+type swapper interface {
+	Swap(i, j int)
+}
+
+// permute realizes the permutation perm (the element currently at index
+// i should end up at index perm[i]) on data using only Swap, by
+// following each permutation cycle. It mutates perm.
+func permute(data swapper, perm []int) {
+	for i := range perm {
+		for perm[i] != i {
+			j := perm[i]
+			data.Swap(i, j)
+			perm[i], perm[j] = perm[j], perm[i]
+		}
+	}
+}
+
+// msdBuckets splits [0, n) into up to 256 buckets by the most significant
+// byte of key(i), counting in parallel chunks across procs goroutines and
+// merging the per-goroutine histograms, then returns the permutation that
+// groups each bucket's elements contiguously along with each bucket's
+// [lo, hi) bounds. Only the counting is parallel: msdBuckets just returns
+// the permutation, it doesn't apply it, since an Interface only exposes
+// Swap and an in-place parallel scatter across goroutines isn't safe
+// without per-worker scratch storage the Interface has no way to supply.
+// The caller applies the permutation with a single sequential call to
+// permute.
+func msdBuckets(n, procs int, key func(i int) uint64) (perm []int, bounds [257]int) {
+	if procs < 1 {
+		procs = 1
+	}
+	chunk := (n + procs - 1) / procs
+	if chunk < 1 {
+		chunk = 1
+	}
+	var tables [][256]int
+	var ranges [][2]int
+	for lo := 0; lo < n; lo += chunk {
+		hi := lo + chunk
+		if hi > n {
+			hi = n
+		}
+		tables = append(tables, [256]int{})
+		ranges = append(ranges, [2]int{lo, hi})
+	}
+	var wg sync.WaitGroup
+	for t := range tables {
+		t := t
+		lo, hi := ranges[t][0], ranges[t][1]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			table := &tables[t]
+			for i := lo; i < hi; i++ {
+				table[byte(key(i)>>56)]++
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Merge per-goroutine histograms into bucket totals, then prefix-sum
+	// into bounds.
+	var totals [256]int
+	for _, table := range tables {
+		for b, c := range table {
+			totals[b] += c
+		}
+	}
+	sum := 0
+	for b, c := range totals {
+		bounds[b] = sum
+		sum += c
+	}
+	bounds[256] = n
+
+	// Compute the target position of each index by walking the input in
+	// order and handing out the next free slot in its bucket. This pass
+	// is sequential (it mutates shared per-bucket cursors) but cheap
+	// relative to the key/histogram work above.
+	cursor := bounds
+	perm = make([]int, n)
+	for i := 0; i < n; i++ {
+		b := byte(key(i) >> 56)
+		perm[i] = cursor[b]
+		cursor[b]++
+	}
+	return perm, bounds
+}
+
+// ByNumberParallel sorts data by partitioning on the most significant key
+// byte, then sorting the resulting buckets concurrently across up to
+// MaxProcs goroutines. The partition's histogram count runs in parallel,
+// but moving elements into their buckets (permute) is a single
+// sequential pass over data: Swap is the only mutation Interface offers,
+// and without per-worker scratch storage to scatter into, splitting that
+// pass across goroutines would race on shared elements. For small inputs
+// it falls back to the serial ByNumber.
+func ByNumberParallel(data NumberInterface) {
+	n := data.Len()
+	if n < smallBucket || MaxProcs < 2 {
+		ByNumber(data)
+		return
+	}
+	perm, bounds := msdBuckets(n, MaxProcs, data.Key)
+	permute(data, perm)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, MaxProcs)
+	for b := 0; b < 256; b++ {
+		lo, hi := bounds[b], bounds[b+1]
+		if hi-lo < 2 {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			ByNumber(&numberOffset{data, lo, hi - lo})
+		}()
+	}
+	wg.Wait()
+}
+
+// ByStringParallel sorts data by partitioning on the first key byte, then
+// sorting the resulting buckets concurrently across up to MaxProcs
+// goroutines. As in ByNumberParallel, only the histogram count runs in
+// parallel; moving elements into their buckets (permute) is a single
+// sequential pass, since Interface only offers Swap and an in-place
+// parallel scatter across goroutines isn't safe. For small inputs it
+// falls back to the serial ByString.
+func ByStringParallel(data StringInterface) {
+	n := data.Len()
+	if n < smallBucket || MaxProcs < 2 {
+		ByString(data)
+		return
+	}
+	keyByte := func(i int) uint64 {
+		s := data.Key(i)
+		if len(s) == 0 {
+			return 0
+		}
+		return uint64(s[0]) << 56
+	}
+	perm, bounds := msdBuckets(n, MaxProcs, keyByte)
+	permute(data, perm)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, MaxProcs)
+	for b := 0; b < 256; b++ {
+		lo, hi := bounds[b], bounds[b+1]
+		if hi-lo < 2 {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			ByString(&stringOffset{data, lo, hi - lo})
+		}()
+	}
+	wg.Wait()
+}
+
+// ByBytesParallel sorts data by partitioning on the first key byte, then
+// sorting the resulting buckets concurrently across up to MaxProcs
+// goroutines. As in ByNumberParallel, only the histogram count runs in
+// parallel; moving elements into their buckets (permute) is a single
+// sequential pass, since Interface only offers Swap and an in-place
+// parallel scatter across goroutines isn't safe. For small inputs it
+// falls back to the serial ByBytes.
+func ByBytesParallel(data BytesInterface) {
+	n := data.Len()
+	if n < smallBucket || MaxProcs < 2 {
+		ByBytes(data)
+		return
+	}
+	keyByte := func(i int) uint64 {
+		k := data.Key(i)
+		if len(k) == 0 {
+			return 0
+		}
+		return uint64(k[0]) << 56
+	}
+	perm, bounds := msdBuckets(n, MaxProcs, keyByte)
+	permute(data, perm)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, MaxProcs)
+	for b := 0; b < 256; b++ {
+		lo, hi := bounds[b], bounds[b+1]
+		if hi-lo < 2 {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			ByBytes(&bytesOffset{data, lo, hi - lo})
+		}()
+	}
+	wg.Wait()
+}
+
+// numberOffset restricts a NumberInterface to the index range
+// [lo, lo+n), presenting it as its own zero-based NumberInterface so the
+// existing serial sorts can finish a bucket produced by ByNumberParallel.
+type numberOffset struct {
+	NumberInterface
+	lo, n int
+}
+
+func (v *numberOffset) Len() int           { return v.n }
+func (v *numberOffset) Less(i, j int) bool { return v.NumberInterface.Less(v.lo+i, v.lo+j) }
+func (v *numberOffset) Swap(i, j int)      { v.NumberInterface.Swap(v.lo+i, v.lo+j) }
+func (v *numberOffset) Key(i int) uint64   { return v.NumberInterface.Key(v.lo + i) }
+
+// stringOffset is the StringInterface analog of numberOffset.
+type stringOffset struct {
+	StringInterface
+	lo, n int
+}
+
+func (v *stringOffset) Len() int           { return v.n }
+func (v *stringOffset) Less(i, j int) bool { return v.StringInterface.Less(v.lo+i, v.lo+j) }
+func (v *stringOffset) Swap(i, j int)      { v.StringInterface.Swap(v.lo+i, v.lo+j) }
+func (v *stringOffset) Key(i int) string   { return v.StringInterface.Key(v.lo + i) }
+
+// bytesOffset is the BytesInterface analog of numberOffset.
+type bytesOffset struct {
+	BytesInterface
+	lo, n int
+}
+
+func (v *bytesOffset) Len() int           { return v.n }
+func (v *bytesOffset) Less(i, j int) bool { return v.BytesInterface.Less(v.lo+i, v.lo+j) }
+func (v *bytesOffset) Swap(i, j int)      { v.BytesInterface.Swap(v.lo+i, v.lo+j) }
+func (v *bytesOffset) Key(i int) []byte   { return v.BytesInterface.Key(v.lo + i) }
+
+// Uint64sParallel sorts a slice of uint64s in increasing order, using up
+// to MaxProcs goroutines.
+func Uint64sParallel(a []uint64) { ByNumberParallel(Uint64Slice(a)) }
+
+// IntsParallel sorts a slice of ints in increasing order, using up to
+// MaxProcs goroutines.
+func IntsParallel(a []int) { ByNumberParallel(IntSlice(a)) }
+
+// Float64sParallel sorts a slice of float64s in increasing order, NaNs
+// last, using up to MaxProcs goroutines.
+func Float64sParallel(a []float64) { ByNumberParallel(Float64Slice(a)) }
+
+// StringsParallel sorts a slice of strings in increasing order, using up
+// to MaxProcs goroutines.
+func StringsParallel(a []string) { ByStringParallel(StringSlice(a)) }
+
+// BytesParallel sorts a slice of byte slices in increasing order, using
+// up to MaxProcs goroutines.
+func BytesParallel(a [][]byte) { ByBytesParallel(BytesSlice(a)) }
@@ -0,0 +1,87 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestByNumberParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	a := make([]int, 5000)
+	for i := range a {
+		a[i] = r.Intn(1 << 20)
+	}
+	want := append([]int(nil), a...)
+	sort.Ints(want)
+
+	IntsParallel(a)
+	for i := range a {
+		if a[i] != want[i] {
+			t.Fatalf("mismatch at %d: got %d, want %d", i, a[i], want[i])
+		}
+	}
+}
+
+func TestByStringParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	letters := "abcdefgh"
+	a := make([]string, 3000)
+	for i := range a {
+		buf := make([]byte, r.Intn(5)+1)
+		for j := range buf {
+			buf[j] = letters[r.Intn(len(letters))]
+		}
+		a[i] = string(buf)
+	}
+	want := append([]string(nil), a...)
+	sort.Strings(want)
+
+	StringsParallel(a)
+	for i := range a {
+		if a[i] != want[i] {
+			t.Fatalf("mismatch at %d: got %q, want %q", i, a[i], want[i])
+		}
+	}
+}
+
+func TestByBytesParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(12))
+	a := make([][]byte, 4000)
+	for i := range a {
+		buf := make([]byte, r.Intn(6)+1)
+		r.Read(buf)
+		a[i] = buf
+	}
+	want := append([][]byte(nil), a...)
+	sort.Slice(want, func(i, j int) bool { return bytes.Compare(want[i], want[j]) < 0 })
+
+	BytesParallel(a)
+	for i := range a {
+		if !bytes.Equal(a[i], want[i]) {
+			t.Fatalf("mismatch at %d", i)
+		}
+	}
+}
+
+func TestSetMaxProcs(t *testing.T) {
+	orig := MaxProcs
+	defer SetMaxProcs(orig)
+
+	SetMaxProcs(0)
+	if MaxProcs != 1 {
+		t.Fatalf("SetMaxProcs(0) left MaxProcs at %d, want 1", MaxProcs)
+	}
+	SetMaxProcs(4)
+	if MaxProcs != 4 {
+		t.Fatalf("SetMaxProcs(4) left MaxProcs at %d, want 4", MaxProcs)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import "sort"
+
+// insertionSortThreshold is the range size below which insertion sort's
+// low overhead beats another radix partitioning pass.
+const insertionSortThreshold = 20
+
+// lessSwapper is the common subset of NumberInterface, StringInterface,
+// and BytesInterface that insertion sort needs; all three satisfy it
+// without any adapter.
+type lessSwapper interface {
+	Less(i, j int) bool
+	Swap(i, j int)
+}
+
+// insertionSort sorts data[lo:hi] in place.
+func insertionSort(data lessSwapper, lo, hi int) {
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && data.Less(j, j-1); j-- {
+			data.Swap(j, j-1)
+		}
+	}
+}
+
+// ByNumber sorts data in increasing order of Key, using an in-place MSD
+// radix sort over the key's 8 bytes, most significant first, and falling
+// back to insertion sort once a bucket is small.
+func ByNumber(data NumberInterface) {
+	numberSort(data, 0, data.Len(), 56)
+}
+
+func numberSort(data NumberInterface, lo, hi int, shift uint) {
+	if hi-lo < 2 {
+		return
+	}
+	if hi-lo <= insertionSortThreshold {
+		insertionSort(data, lo, hi)
+		return
+	}
+
+	var counts [256]int
+	for i := lo; i < hi; i++ {
+		counts[byte(data.Key(i)>>shift)]++
+	}
+	var starts [257]int
+	sum := lo
+	for b, c := range counts {
+		starts[b] = sum
+		sum += c
+	}
+	starts[256] = hi
+
+	// In-place counting sort: cursor[b] is the next unplaced slot in
+	// bucket b's region. Visit buckets in order; whatever currently sits
+	// at cursor[b] either already belongs there (advance) or gets
+	// swapped into its own bucket's next free slot. Every swap seats at
+	// least one element correctly, so this terminates in O(hi-lo) swaps.
+	cursor := starts
+	for b := 0; b < 256; b++ {
+		for cursor[b] < starts[b+1] {
+			x := byte(data.Key(cursor[b]) >> shift)
+			if int(x) == b {
+				cursor[b]++
+			} else {
+				data.Swap(cursor[b], cursor[x])
+				cursor[x]++
+			}
+		}
+	}
+
+	if shift == 0 {
+		return
+	}
+	for b := 0; b < 256; b++ {
+		if starts[b+1]-starts[b] > 1 {
+			numberSort(data, starts[b], starts[b+1], shift-8)
+		}
+	}
+}
+
+// stringByteAt returns the byte of s at depth d, offset by one so that 0
+// is free to mean "s ended before d" and sorts before any real byte.
+func stringByteAt(s string, d int) int {
+	if d >= len(s) {
+		return 0
+	}
+	return int(s[d]) + 1
+}
+
+// ByString sorts data in increasing order of Key, using an in-place MSD
+// radix sort over the key's bytes, falling back to insertion sort once a
+// bucket is small.
+func ByString(data StringInterface) {
+	stringSort(data, 0, data.Len(), 0)
+}
+
+func stringSort(data StringInterface, lo, hi, depth int) {
+	if hi-lo < 2 {
+		return
+	}
+	if hi-lo <= insertionSortThreshold {
+		insertionSort(data, lo, hi)
+		return
+	}
+
+	var counts [257]int
+	for i := lo; i < hi; i++ {
+		counts[stringByteAt(data.Key(i), depth)]++
+	}
+	var starts [258]int
+	sum := lo
+	for b, c := range counts {
+		starts[b] = sum
+		sum += c
+	}
+	starts[257] = hi
+
+	cursor := starts
+	for b := 0; b < 257; b++ {
+		for cursor[b] < starts[b+1] {
+			x := stringByteAt(data.Key(cursor[b]), depth)
+			if x == b {
+				cursor[b]++
+			} else {
+				data.Swap(cursor[b], cursor[x])
+				cursor[x]++
+			}
+		}
+	}
+
+	// Bucket 0 holds keys that ended exactly at depth: since everything
+	// in [lo, hi) already shares the same first depth bytes, those keys
+	// are identical strings and need no further sorting.
+	for b := 1; b < 257; b++ {
+		if starts[b+1]-starts[b] > 1 {
+			stringSort(data, starts[b], starts[b+1], depth+1)
+		}
+	}
+}
+
+// bytesByteAt is stringByteAt's []byte analog.
+func bytesByteAt(s []byte, d int) int {
+	if d >= len(s) {
+		return 0
+	}
+	return int(s[d]) + 1
+}
+
+// ByBytes sorts data in increasing order of Key, using an in-place MSD
+// radix sort over the key's bytes, falling back to insertion sort once a
+// bucket is small.
+func ByBytes(data BytesInterface) {
+	bytesSort(data, 0, data.Len(), 0)
+}
+
+func bytesSort(data BytesInterface, lo, hi, depth int) {
+	if hi-lo < 2 {
+		return
+	}
+	if hi-lo <= insertionSortThreshold {
+		insertionSort(data, lo, hi)
+		return
+	}
+
+	var counts [257]int
+	for i := lo; i < hi; i++ {
+		counts[bytesByteAt(data.Key(i), depth)]++
+	}
+	var starts [258]int
+	sum := lo
+	for b, c := range counts {
+		starts[b] = sum
+		sum += c
+	}
+	starts[257] = hi
+
+	cursor := starts
+	for b := 0; b < 257; b++ {
+		for cursor[b] < starts[b+1] {
+			x := bytesByteAt(data.Key(cursor[b]), depth)
+			if x == b {
+				cursor[b]++
+			} else {
+				data.Swap(cursor[b], cursor[x])
+				cursor[x]++
+			}
+		}
+	}
+
+	for b := 1; b < 257; b++ {
+		if starts[b+1]-starts[b] > 1 {
+			bytesSort(data, starts[b], starts[b+1], depth+1)
+		}
+	}
+}
+
+// IsSorted reports whether data is sorted in increasing order.
+func IsSorted(data sort.Interface) bool {
+	for i := data.Len() - 1; i > 0; i-- {
+		if data.Less(i, i-1) {
+			return false
+		}
+	}
+	return true
+}
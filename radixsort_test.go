@@ -0,0 +1,93 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestByNumber(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 20; trial++ {
+		a := make([]int, r.Intn(2000))
+		for i := range a {
+			a[i] = r.Intn(1000) - 500
+		}
+		want := append([]int(nil), a...)
+		sort.Ints(want)
+
+		Ints(a)
+		for i := range a {
+			if a[i] != want[i] {
+				t.Fatalf("trial %d: mismatch at %d: got %v, want %v", trial, i, a[i], want[i])
+			}
+		}
+		if !IntsAreSorted(a) {
+			t.Fatalf("trial %d: IntsAreSorted returned false for a sorted slice", trial)
+		}
+	}
+}
+
+func TestByString(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	letters := "abc"
+	for trial := 0; trial < 20; trial++ {
+		a := make([]string, r.Intn(2000))
+		for i := range a {
+			buf := make([]byte, r.Intn(6))
+			for j := range buf {
+				buf[j] = letters[r.Intn(len(letters))]
+			}
+			a[i] = string(buf)
+		}
+		want := append([]string(nil), a...)
+		sort.Strings(want)
+
+		Strings(a)
+		for i := range a {
+			if a[i] != want[i] {
+				t.Fatalf("trial %d: mismatch at %d: got %q, want %q", trial, i, a[i], want[i])
+			}
+		}
+	}
+}
+
+func TestByBytes(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 20; trial++ {
+		a := make([][]byte, r.Intn(2000))
+		for i := range a {
+			buf := make([]byte, r.Intn(6))
+			r.Read(buf)
+			a[i] = buf
+		}
+		want := append([][]byte(nil), a...)
+		sort.Slice(want, func(i, j int) bool { return string(want[i]) < string(want[j]) })
+
+		Bytes(a)
+		for i := range a {
+			if string(a[i]) != string(want[i]) {
+				t.Fatalf("trial %d: mismatch at %d", trial, i)
+			}
+		}
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	if !IsSorted(IntSlice(nil)) {
+		t.Fatalf("empty slice should report sorted")
+	}
+	if !IsSorted(IntSlice{1, 2, 2, 3}) {
+		t.Fatalf("non-decreasing slice should report sorted")
+	}
+	if IsSorted(IntSlice{3, 1, 2}) {
+		t.Fatalf("unsorted slice should not report sorted")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"bytes"
+	"sort"
+)
+
+// SearchByNumberKey mirrors the sort.Search contract: given a slice of
+// length n ordered by key in increasing order (as ByNumber would leave
+// it), SearchByNumberKey returns the smallest index i such that
+// key(i) >= target, or n if no such index exists. Callers sorting with
+// ByNumberFunc should search with the same key function so the ordering
+// matches.
+func SearchByNumberKey(n int, key func(i int) uint64, target uint64) int {
+	return sort.Search(n, func(i int) bool { return key(i) >= target })
+}
+
+// SearchInts searches a sorted slice of ints and returns the smallest
+// index at which x could be inserted to keep a in order, matching
+// sort.SearchInts.
+func SearchInts(a []int, x int) int {
+	target := IntKey(x)
+	return SearchByNumberKey(len(a), func(i int) uint64 { return IntKey(a[i]) }, target)
+}
+
+// SearchInt32s searches a sorted slice of int32s and returns the smallest
+// index at which x could be inserted to keep a in order.
+func SearchInt32s(a []int32, x int32) int {
+	target := Int32Key(x)
+	return SearchByNumberKey(len(a), func(i int) uint64 { return Int32Key(a[i]) }, target)
+}
+
+// SearchInt64s searches a sorted slice of int64s and returns the smallest
+// index at which x could be inserted to keep a in order, matching
+// sort.SearchInt64s.
+func SearchInt64s(a []int64, x int64) int {
+	target := Int64Key(x)
+	return SearchByNumberKey(len(a), func(i int) uint64 { return Int64Key(a[i]) }, target)
+}
+
+// SearchFloat64s searches a sorted slice of float64s (NaNs last, as
+// ByNumber leaves them) and returns the smallest index at which x could
+// be inserted to keep a in order.
+func SearchFloat64s(a []float64, x float64) int {
+	target := Float64Key(x)
+	return SearchByNumberKey(len(a), func(i int) uint64 { return Float64Key(a[i]) }, target)
+}
+
+// SearchStrings searches a sorted slice of strings and returns the
+// smallest index at which x could be inserted to keep a in order,
+// matching sort.SearchStrings.
+func SearchStrings(a []string, x string) int {
+	return sort.Search(len(a), func(i int) bool { return a[i] >= x })
+}
+
+// SearchBytes searches a sorted slice of byte slices and returns the
+// smallest index at which x could be inserted to keep a in order.
+func SearchBytes(a [][]byte, x []byte) int {
+	return sort.Search(len(a), func(i int) bool { return bytes.Compare(a[i], x) >= 0 })
+}
@@ -0,0 +1,56 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestSearchInts(t *testing.T) {
+	a := []int{-5, -1, 0, 0, 3, 7, 42}
+	for _, x := range []int{-10, -5, -2, 0, 1, 7, 100} {
+		got := SearchInts(a, x)
+		want := sort.SearchInts(a, x)
+		if got != want {
+			t.Errorf("SearchInts(a, %d) = %d, want %d", x, got, want)
+		}
+	}
+}
+
+func TestSearchFloat64sNaNLast(t *testing.T) {
+	a := []float64{-1.5, 0, 2.5, math.NaN()}
+	if got := SearchFloat64s(a, 2.5); got != 2 {
+		t.Fatalf("SearchFloat64s(a, 2.5) = %d, want 2", got)
+	}
+	if got := SearchFloat64s(a, math.Inf(1)); got != 3 {
+		t.Fatalf("SearchFloat64s(a, +Inf) = %d, want 3 (before the NaN tail)", got)
+	}
+}
+
+func TestSearchStrings(t *testing.T) {
+	a := []string{"alice", "bob", "bob", "carol"}
+	for _, x := range []string{"", "alice", "bob", "bp", "zz"} {
+		got := SearchStrings(a, x)
+		want := sort.SearchStrings(a, x)
+		if got != want {
+			t.Errorf("SearchStrings(a, %q) = %d, want %d", x, got, want)
+		}
+	}
+}
+
+func TestSearchBytes(t *testing.T) {
+	a := [][]byte{[]byte("alice"), []byte("bob"), []byte("carol")}
+	if got := SearchBytes(a, []byte("bob")); got != 1 {
+		t.Fatalf("SearchBytes(a, bob) = %d, want 1", got)
+	}
+	if got := SearchBytes(a, []byte("zz")); got != 3 {
+		t.Fatalf("SearchBytes(a, zz) = %d, want 3", got)
+	}
+}
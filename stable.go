@@ -0,0 +1,184 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+// ByNumberStable sorts data by LSD radix sort, processing key bytes
+// low-to-high with a stable counting-sort pass per byte, so elements
+// with equal keys keep their relative input order. Unlike ByNumber, it
+// never mutates data directly: it computes the resulting permutation in
+// an auxiliary []int and then realizes it via Swap, so it costs O(n)
+// extra ints and no extra element storage.
+func ByNumberStable(data NumberInterface) {
+	n := data.Len()
+	if n < 2 {
+		return
+	}
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	keys := make([]uint64, n)
+	for i := 0; i < n; i++ {
+		keys[i] = data.Key(i)
+	}
+	aux := make([]int, n)
+	var counts [256]int
+	for shift := uint(0); shift < 64; shift += 8 {
+		for i := range counts {
+			counts[i] = 0
+		}
+		for _, idx := range perm {
+			counts[byte(keys[idx]>>shift)]++
+		}
+		sum := 0
+		for b, c := range counts {
+			counts[b] = sum
+			sum += c
+		}
+		for _, idx := range perm {
+			b := byte(keys[idx] >> shift)
+			aux[counts[b]] = idx
+			counts[b]++
+		}
+		perm, aux = aux, perm
+	}
+
+	// perm[i] is now the original index that belongs at position i;
+	// invert it into forward (current index -> target index) form, the
+	// semantics permute expects, then apply it with Swap.
+	inverse := aux // reuse the other buffer
+	for target, src := range perm {
+		inverse[src] = target
+	}
+	permute(data, inverse)
+}
+
+// ByStringStable sorts data by a stable MSD radix sort: recursively,
+// each level does one stable counting-sort pass over an auxiliary []int
+// index array by the byte at the current depth, then recurses into each
+// resulting bucket for the next depth. Because the scatter pass writes
+// into aux in the order it visits idx rather than swapping elements in
+// data, elements with equal keys keep their relative input order. Only
+// once the whole permutation is known is it realized against data, via
+// Swap, so this costs O(n) extra ints and no extra element storage.
+func ByStringStable(data StringInterface) {
+	n := data.Len()
+	if n < 2 {
+		return
+	}
+	keys := make([]string, n)
+	for i := 0; i < n; i++ {
+		keys[i] = data.Key(i)
+	}
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	stringStableSort(keys, idx, make([]int, n), 0, n, 0)
+
+	inverse := make([]int, n)
+	for target, src := range idx {
+		inverse[src] = target
+	}
+	permute(data, inverse)
+}
+
+// stringStableSort stably sorts idx[lo:hi] by keys[idx[i]]'s byte at
+// depth, recursing into each resulting bucket for depth+1. aux is
+// scratch space the size of idx.
+func stringStableSort(keys []string, idx, aux []int, lo, hi, depth int) {
+	if hi-lo < 2 {
+		return
+	}
+	var counts [257]int
+	for i := lo; i < hi; i++ {
+		counts[stringByteAt(keys[idx[i]], depth)]++
+	}
+	var starts [258]int
+	sum := 0
+	for b, c := range counts {
+		starts[b] = sum
+		sum += c
+	}
+	starts[257] = sum
+
+	cursor := starts
+	for i := lo; i < hi; i++ {
+		b := stringByteAt(keys[idx[i]], depth)
+		aux[lo+cursor[b]] = idx[i]
+		cursor[b]++
+	}
+	copy(idx[lo:hi], aux[lo:hi])
+
+	// Bucket 0 holds keys that ended exactly at depth: since everything
+	// in [lo, hi) already shares the same first depth bytes, those keys
+	// are identical strings and need no further sorting.
+	for b := 1; b < 257; b++ {
+		bLo, bHi := lo+starts[b], lo+starts[b+1]
+		if bHi-bLo > 1 {
+			stringStableSort(keys, idx, aux, bLo, bHi, depth+1)
+		}
+	}
+}
+
+// ByBytesStable sorts data by a stable MSD radix sort, the []byte analog
+// of ByStringStable.
+func ByBytesStable(data BytesInterface) {
+	n := data.Len()
+	if n < 2 {
+		return
+	}
+	keys := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		keys[i] = data.Key(i)
+	}
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	bytesStableSort(keys, idx, make([]int, n), 0, n, 0)
+
+	inverse := make([]int, n)
+	for target, src := range idx {
+		inverse[src] = target
+	}
+	permute(data, inverse)
+}
+
+// bytesStableSort is stringStableSort's []byte analog.
+func bytesStableSort(keys [][]byte, idx, aux []int, lo, hi, depth int) {
+	if hi-lo < 2 {
+		return
+	}
+	var counts [257]int
+	for i := lo; i < hi; i++ {
+		counts[bytesByteAt(keys[idx[i]], depth)]++
+	}
+	var starts [258]int
+	sum := 0
+	for b, c := range counts {
+		starts[b] = sum
+		sum += c
+	}
+	starts[257] = sum
+
+	cursor := starts
+	for i := lo; i < hi; i++ {
+		b := bytesByteAt(keys[idx[i]], depth)
+		aux[lo+cursor[b]] = idx[i]
+		cursor[b]++
+	}
+	copy(idx[lo:hi], aux[lo:hi])
+
+	for b := 1; b < 257; b++ {
+		bLo, bHi := lo+starts[b], lo+starts[b+1]
+		if bHi-bLo > 1 {
+			bytesStableSort(keys, idx, aux, bLo, bHi, depth+1)
+		}
+	}
+}
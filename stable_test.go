@@ -0,0 +1,112 @@
+// Copyright 2009 The Go Authors.
+// Copyright 2015 Randall Farmer.
+// All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package radixsort
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+type numberPair struct {
+	key uint64
+	seq int
+}
+type numberPairSlice []numberPair
+
+func (p numberPairSlice) Len() int           { return len(p) }
+func (p numberPairSlice) Less(i, j int) bool { return p[i].key < p[j].key }
+func (p numberPairSlice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p numberPairSlice) Key(i int) uint64   { return p[i].key }
+
+func TestByNumberStable(t *testing.T) {
+	r := rand.New(rand.NewSource(30))
+	n := 3000
+	a := make(numberPairSlice, n)
+	for i := range a {
+		a[i] = numberPair{key: uint64(r.Intn(10)), seq: i}
+	}
+	ByNumberStable(a)
+	if !sort.IsSorted(a) {
+		t.Fatalf("not sorted")
+	}
+	for i := 1; i < n; i++ {
+		if a[i-1].key == a[i].key && a[i-1].seq > a[i].seq {
+			t.Fatalf("not stable at %d: %+v %+v", i, a[i-1], a[i])
+		}
+	}
+}
+
+type stringPair struct {
+	key string
+	seq int
+}
+type stringPairSlice []stringPair
+
+func (p stringPairSlice) Len() int           { return len(p) }
+func (p stringPairSlice) Less(i, j int) bool { return p[i].key < p[j].key }
+func (p stringPairSlice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p stringPairSlice) Key(i int) string   { return p[i].key }
+
+func TestByStringStable(t *testing.T) {
+	r := rand.New(rand.NewSource(31))
+	n := 2000
+	letters := "ab"
+	a := make(stringPairSlice, n)
+	for i := range a {
+		buf := make([]byte, r.Intn(3)+1)
+		for j := range buf {
+			buf[j] = letters[r.Intn(len(letters))]
+		}
+		a[i] = stringPair{key: string(buf), seq: i}
+	}
+	ByStringStable(a)
+	if !sort.IsSorted(a) {
+		t.Fatalf("not sorted")
+	}
+	for i := 1; i < n; i++ {
+		if a[i-1].key == a[i].key && a[i-1].seq > a[i].seq {
+			t.Fatalf("not stable at %d", i)
+		}
+	}
+}
+
+type bytesPair struct {
+	key []byte
+	seq int
+}
+type bytesPairSlice []bytesPair
+
+func (p bytesPairSlice) Len() int           { return len(p) }
+func (p bytesPairSlice) Less(i, j int) bool { return lessBytes(p[i].key, p[j].key) }
+func (p bytesPairSlice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p bytesPairSlice) Key(i int) []byte   { return p[i].key }
+
+func TestByBytesStable(t *testing.T) {
+	r := rand.New(rand.NewSource(32))
+	n := 2000
+	a := make(bytesPairSlice, n)
+	for i := range a {
+		buf := make([]byte, r.Intn(4))
+		// A tiny alphabet biases toward duplicate keys and short common
+		// prefixes, exercising the recursion and terminator bucket.
+		for j := range buf {
+			buf[j] = byte(r.Intn(3))
+		}
+		a[i] = bytesPair{key: buf, seq: i}
+	}
+	ByBytesStable(a)
+	if !sort.IsSorted(a) {
+		t.Fatalf("not sorted")
+	}
+	for i := 1; i < n; i++ {
+		if string(a[i-1].key) == string(a[i].key) && a[i-1].seq > a[i].seq {
+			t.Fatalf("not stable at %d", i)
+		}
+	}
+}